@@ -0,0 +1,134 @@
+// Package analyzer walks Go source files with go/ast and runs the
+// registered set of rules against them, collecting findings.
+package analyzer
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Severity classifies how serious a finding is.
+type Severity string
+
+// Severity levels, ordered low to high.
+const (
+	SeverityInfo     Severity = "info"
+	SeverityLow      Severity = "low"
+	SeverityMedium   Severity = "medium"
+	SeverityHigh     Severity = "high"
+	SeverityCritical Severity = "critical"
+)
+
+// Finding is a single rule violation located in a source file.
+type Finding struct {
+	Rule     string
+	Message  string
+	File     string
+	Line     int
+	Column   int
+	Severity Severity
+}
+
+// Pass carries the state a Rule needs to inspect one file and report
+// findings against it.
+type Pass struct {
+	Fset *token.FileSet
+	File *ast.File
+	Path string
+
+	findings *[]Finding
+}
+
+// Report records a finding anchored at n's position.
+func (p *Pass) Report(n ast.Node, rule, message string, sev Severity) {
+	pos := p.Fset.Position(n.Pos())
+	*p.findings = append(*p.findings, Finding{
+		Rule:     rule,
+		Message:  message,
+		File:     p.Path,
+		Line:     pos.Line,
+		Column:   pos.Column,
+		Severity: sev,
+	})
+}
+
+// Rule inspects a single file's AST and reports any findings via the
+// Pass it is given.
+type Rule interface {
+	ID() string
+	Check(p *Pass)
+}
+
+// funcRule adapts a plain check function to the Rule interface so
+// rules can be declared without a dedicated type.
+type funcRule struct {
+	id    string
+	check func(*Pass)
+}
+
+func (r funcRule) ID() string    { return r.id }
+func (r funcRule) Check(p *Pass) { r.check(p) }
+
+var rules []Rule
+
+// Register adds a rule to the set run by ScanPaths. Rules
+// register themselves from init() in their own source file.
+func Register(r Rule) {
+	rules = append(rules, r)
+}
+
+// ScanPaths walks paths (files or directories) and scans every .go
+// file found, skipping vendor directories.
+func ScanPaths(paths []string) ([]Finding, error) {
+	var all []Finding
+	fset := token.NewFileSet()
+
+	for _, root := range paths {
+		err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				if info.Name() == "vendor" || info.Name() == ".git" {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !strings.HasSuffix(path, ".go") {
+				return nil
+			}
+			findings, err := scanFile(fset, path)
+			if err != nil {
+				return err
+			}
+			all = append(all, findings...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
+
+func scanFile(fset *token.FileSet, path string) ([]Finding, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []Finding
+	pass := &Pass{Fset: fset, File: file, Path: path, findings: &findings}
+	for _, r := range rules {
+		r.Check(pass)
+	}
+	return findings, nil
+}
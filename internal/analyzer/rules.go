@@ -0,0 +1,337 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+)
+
+func init() {
+	Register(funcRule{"global_vars", checkGlobalVars})
+	Register(funcRule{"exposed_secrets", checkExposedSecrets})
+	Register(funcRule{"recursion", checkRecursion})
+	Register(funcRule{"multiple_returns", checkMultipleReturns})
+	Register(funcRule{"dynamic_memory", checkDynamicMemory})
+	Register(funcRule{"unbounded_loops", checkUnboundedLoops})
+	Register(funcRule{"complex_flow", checkComplexFlow})
+	Register(funcRule{"async_risk", checkAsyncRisk})
+	Register(funcRule{"set_timeout", checkSetTimeout})
+	Register(funcRule{"eval_usage", checkEvalUsage})
+	Register(funcRule{"nested_conditionals", checkNestedConditionals})
+	Register(funcRule{"unsafe_input", checkUnsafeInput})
+	Register(funcRule{"unsafe_file_op", checkUnsafeFileOp})
+	Register(funcRule{"network_call", checkNetworkCall})
+	Register(funcRule{"weak_crypto", checkWeakCrypto})
+	Register(funcRule{"unsanitized_exec", checkUnsanitizedExec})
+}
+
+// asCall reports whether n is a call expression.
+func asCall(n ast.Node) (*ast.CallExpr, bool) {
+	c, ok := n.(*ast.CallExpr)
+	return c, ok
+}
+
+// selectorName splits a "pkg.Name" selector expression into its two
+// identifiers. ok is false for anything that isn't a plain selector
+// on a bare identifier (e.g. a chained call like f().Name).
+func selectorName(expr ast.Expr) (pkg, name string, ok bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return "", "", false
+	}
+	return ident.Name, sel.Sel.Name, true
+}
+
+func checkGlobalVars(p *Pass) {
+	for _, decl := range p.File.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for _, name := range vs.Names {
+				if name.Name == "_" {
+					continue
+				}
+				p.Report(vs, "global_vars", fmt.Sprintf("package-level mutable state %q", name.Name), SeverityLow)
+			}
+		}
+	}
+}
+
+var secretNameRe = regexp.MustCompile(`(?i)key|secret|token|password|passwd|credential`)
+
+func checkExposedSecrets(p *Pass) {
+	for _, decl := range p.File.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || (gd.Tok != token.VAR && gd.Tok != token.CONST) {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if i >= len(vs.Values) || !secretNameRe.MatchString(name.Name) {
+					continue
+				}
+				if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+					p.Report(lit, "exposed_secrets", fmt.Sprintf("hardcoded secret in %q", name.Name), SeverityCritical)
+				}
+			}
+		}
+	}
+}
+
+func checkRecursion(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		fd, ok := n.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			return true
+		}
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			call, ok := asCall(n)
+			if !ok {
+				return true
+			}
+			if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == fd.Name.Name {
+				p.Report(call, "recursion", fmt.Sprintf("%s calls itself recursively", fd.Name.Name), SeverityLow)
+			}
+			return true
+		})
+		return true
+	})
+}
+
+func checkMultipleReturns(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		fd, ok := n.(*ast.FuncDecl)
+		if !ok || fd.Body == nil {
+			return true
+		}
+		count := 0
+		ast.Inspect(fd.Body, func(n ast.Node) bool {
+			if _, ok := n.(*ast.ReturnStmt); ok {
+				count++
+			}
+			return true
+		})
+		if count > 1 {
+			p.Report(fd, "multiple_returns", fmt.Sprintf("%s has %d return statements", fd.Name.Name, count), SeverityInfo)
+		}
+		return true
+	})
+}
+
+func checkDynamicMemory(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "make" {
+			p.Report(call, "dynamic_memory", "dynamic allocation via make", SeverityInfo)
+		}
+		return true
+	})
+}
+
+func checkUnboundedLoops(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		fs, ok := n.(*ast.ForStmt)
+		if !ok {
+			return true
+		}
+		if fs.Cond == nil {
+			p.Report(fs, "unbounded_loops", "for loop has no exit condition", SeverityMedium)
+		}
+		return true
+	})
+}
+
+func checkComplexFlow(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		bs, ok := n.(*ast.BranchStmt)
+		if !ok {
+			return true
+		}
+		p.Report(bs, "complex_flow", fmt.Sprintf("%s statement complicates control flow", bs.Tok), SeverityInfo)
+		return true
+	})
+}
+
+func checkAsyncRisk(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		gs, ok := n.(*ast.GoStmt)
+		if !ok {
+			return true
+		}
+		p.Report(gs, "async_risk", "goroutine launched without visible synchronization", SeverityMedium)
+		return true
+	})
+}
+
+func checkSetTimeout(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok || pkg != "time" || name != "Sleep" {
+			return true
+		}
+		p.Report(call, "set_timeout", "timing-dependent behavior via time.Sleep", SeverityLow)
+		return true
+	})
+}
+
+func checkEvalUsage(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok || pkg != "exec" || name != "Command" {
+			return true
+		}
+		p.Report(call, "eval_usage", "dynamic command execution via exec.Command", SeverityHigh)
+		return true
+	})
+}
+
+func checkNestedConditionals(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		outer, ok := n.(*ast.IfStmt)
+		if !ok {
+			return true
+		}
+		for _, stmt := range outer.Body.List {
+			if _, ok := stmt.(*ast.IfStmt); ok {
+				p.Report(outer, "nested_conditionals", "nested if statements hurt readability", SeverityInfo)
+				break
+			}
+		}
+		return true
+	})
+}
+
+func checkUnsafeInput(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		switch sel.Sel.Name {
+		case "ReadString", "ReadLine", "ReadBytes":
+			p.Report(call, "unsafe_input", "unvalidated interactive input read", SeverityMedium)
+		}
+		return true
+	})
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == "os" && sel.Sel.Name == "Args" {
+			p.Report(sel, "unsafe_input", "unvalidated command-line input via os.Args", SeverityLow)
+		}
+		return true
+	})
+}
+
+func checkUnsafeFileOp(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok {
+			return true
+		}
+		if pkg == "ioutil" && (name == "ReadFile" || name == "WriteFile") {
+			p.Report(call, "unsafe_file_op", fmt.Sprintf("ioutil.%s used without validating the path", name), SeverityMedium)
+		}
+		return true
+	})
+}
+
+func checkNetworkCall(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok || pkg != "http" {
+			return true
+		}
+		switch name {
+		case "Get", "Post", "PostForm", "Head", "Do":
+			p.Report(call, "network_call", fmt.Sprintf("network call via http.%s", name), SeverityMedium)
+		}
+		return true
+	})
+}
+
+func checkWeakCrypto(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok || name != "New" {
+			return true
+		}
+		switch pkg {
+		case "md5", "sha1", "des", "rc4":
+			p.Report(call, "weak_crypto", fmt.Sprintf("%s is not a secure cryptographic primitive", pkg), SeverityHigh)
+		}
+		return true
+	})
+}
+
+func checkUnsanitizedExec(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok || pkg != "exec" || name != "Command" {
+			return true
+		}
+		for _, arg := range call.Args {
+			if containsConcat(arg) {
+				p.Report(call, "unsanitized_exec", "exec.Command argument built via string concatenation", SeverityCritical)
+				break
+			}
+		}
+		return true
+	})
+}
+
+func containsConcat(expr ast.Expr) bool {
+	be, ok := expr.(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	return be.Op == token.ADD
+}
@@ -0,0 +1,118 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Deprecation describes a package/symbol pair that should no longer
+// be used and what to replace it with. Users can append their own via
+// LoadDeprecationRules instead of recompiling spc.
+type Deprecation struct {
+	Package     string `yaml:"package"`
+	Symbol      string `yaml:"symbol"`
+	Replacement string `yaml:"replacement"`
+	Reason      string `yaml:"reason"`
+}
+
+// deprecations is the built-in set, extended at runtime by
+// LoadDeprecationRules.
+var deprecations = []Deprecation{
+	{Package: "ioutil", Symbol: "ReadFile", Replacement: "os.ReadFile", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "WriteFile", Replacement: "os.WriteFile", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "ReadAll", Replacement: "io.ReadAll", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "ReadDir", Replacement: "os.ReadDir", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "NopCloser", Replacement: "io.NopCloser", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "Discard", Replacement: "io.Discard", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "TempFile", Replacement: "os.CreateTemp", Reason: "io/ioutil is deprecated as of Go 1.16"},
+	{Package: "ioutil", Symbol: "TempDir", Replacement: "os.MkdirTemp", Reason: "io/ioutil is deprecated as of Go 1.16"},
+}
+
+func init() {
+	Register(funcRule{"deprecated_api", checkDeprecatedAPI})
+}
+
+// LoadDeprecationRules reads a YAML file of additional deprecations
+// and merges them into the built-in set. The file is a plain list.
+// Package is matched against the identifier a call is made through
+// (e.g. "jwt" for `jwt.ParseWithClaims(...)`), not the import path, so
+// a rule this broad also matches any other import sharing that local
+// name (golang-jwt/jwt included):
+//
+//   - package: jwt
+//     symbol: ParseWithClaims
+//     replacement: golang-jwt/jwt.ParseWithClaims
+//     reason: unmaintained since 2021
+func LoadDeprecationRules(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("loading deprecation rules: %w", err)
+	}
+	var extra []Deprecation
+	if err := yaml.Unmarshal(data, &extra); err != nil {
+		return fmt.Errorf("parsing deprecation rules %s: %w", path, err)
+	}
+	deprecations = append(deprecations, extra...)
+	return nil
+}
+
+func checkDeprecatedAPI(p *Pass) {
+	checkDeprecatedImports(p)
+	checkDeprecatedCalls(p)
+	checkLibPQWithDatabaseSQL(p)
+}
+
+func checkDeprecatedImports(p *Pass) {
+	for _, imp := range p.File.Imports {
+		path := strings.Trim(imp.Path.Value, `"`)
+		if path == "github.com/dgrijalva/jwt-go" {
+			p.Report(imp, "deprecated_api", "github.com/dgrijalva/jwt-go is unmaintained; migrate to github.com/golang-jwt/jwt/v5", SeverityMedium)
+		}
+	}
+}
+
+func checkDeprecatedCalls(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		pkg, name, ok := selectorName(call.Fun)
+		if !ok {
+			return true
+		}
+		for _, d := range deprecations {
+			if d.Package != pkg || d.Symbol != name {
+				continue
+			}
+			msg := fmt.Sprintf("%s.%s is deprecated; use %s instead", pkg, name, d.Replacement)
+			if d.Reason != "" {
+				msg += " (" + d.Reason + ")"
+			}
+			p.Report(call, "deprecated_api", msg, SeverityLow)
+		}
+		return true
+	})
+}
+
+// checkLibPQWithDatabaseSQL flags the combination that most commonly
+// signals a project is ready to move to pgx: the pure database/sql
+// driver form of lib/pq, which only gets security fixes these days.
+func checkLibPQWithDatabaseSQL(p *Pass) {
+	var hasLibPQ, hasDatabaseSQL bool
+	for _, imp := range p.File.Imports {
+		switch strings.Trim(imp.Path.Value, `"`) {
+		case "github.com/lib/pq":
+			hasLibPQ = true
+		case "database/sql":
+			hasDatabaseSQL = true
+		}
+	}
+	if hasLibPQ && hasDatabaseSQL {
+		p.Report(p.File, "deprecated_api", "github.com/lib/pq is in maintenance mode; consider migrating to github.com/jackc/pgx/v5", SeverityLow)
+	}
+}
@@ -0,0 +1,107 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCheckDeprecatedImports_JWTGo(t *testing.T) {
+	const src = `package sample
+
+import "github.com/dgrijalva/jwt-go"
+
+func main() {
+	_ = jwt.StandardClaims{}
+}
+`
+	findings := findingsForRule(scanSource(t, src), "deprecated_api")
+	if len(findings) == 0 {
+		t.Fatalf("expected deprecated_api finding for github.com/dgrijalva/jwt-go import")
+	}
+}
+
+func TestCheckDeprecatedCalls_IoutilReadFile(t *testing.T) {
+	const src = `package sample
+
+import "io/ioutil"
+
+func main() {
+	ioutil.ReadFile("x.txt")
+}
+`
+	findings := findingsForRule(scanSource(t, src), "deprecated_api")
+	if len(findings) == 0 {
+		t.Fatalf("expected deprecated_api finding for ioutil.ReadFile")
+	}
+}
+
+func TestCheckLibPQWithDatabaseSQL(t *testing.T) {
+	const src = `package sample
+
+import (
+	"database/sql"
+
+	_ "github.com/lib/pq"
+)
+
+func main() {
+	sql.Open("postgres", "")
+}
+`
+	findings := findingsForRule(scanSource(t, src), "deprecated_api")
+	if len(findings) == 0 {
+		t.Fatalf("expected deprecated_api finding for lib/pq combined with database/sql")
+	}
+}
+
+func TestCheckLibPQWithDatabaseSQL_PQAloneNotFlagged(t *testing.T) {
+	const src = `package sample
+
+import _ "github.com/lib/pq"
+
+func main() {}
+`
+	findings := findingsForRule(scanSource(t, src), "deprecated_api")
+	for _, f := range findings {
+		if f.Message == "github.com/lib/pq is in maintenance mode; consider migrating to github.com/jackc/pgx/v5" {
+			t.Fatalf("lib/pq import without database/sql should not trigger the migration finding")
+		}
+	}
+}
+
+func TestLoadDeprecationRules(t *testing.T) {
+	before := append([]Deprecation(nil), deprecations...)
+	t.Cleanup(func() { deprecations = before })
+
+	const yamlContent = `- package: pq
+  symbol: Open
+  replacement: github.com/jackc/pgx/v5/stdlib.Open
+  reason: lib/pq only receives security fixes
+`
+	path := filepath.Join(t.TempDir(), "extra.yaml")
+	if err := os.WriteFile(path, []byte(yamlContent), 0o644); err != nil {
+		t.Fatalf("writing rules file: %v", err)
+	}
+
+	if err := LoadDeprecationRules(path); err != nil {
+		t.Fatalf("LoadDeprecationRules: %v", err)
+	}
+
+	const src = `package sample
+
+func main() {
+	pq.Open("")
+}
+`
+	findings := findingsForRule(scanSource(t, src), "deprecated_api")
+	if len(findings) == 0 {
+		t.Fatalf("expected deprecated_api finding for user-supplied pq.Open deprecation")
+	}
+}
+
+func TestLoadDeprecationRules_MissingFile(t *testing.T) {
+	if err := LoadDeprecationRules(filepath.Join(t.TempDir(), "does-not-exist.yaml")); err == nil {
+		t.Fatalf("expected an error loading a nonexistent rules file")
+	}
+}
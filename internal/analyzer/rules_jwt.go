@@ -0,0 +1,236 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+)
+
+// jwtSecretNameRe matches identifiers that strongly suggest the value
+// backs a JWT signature rather than general request data.
+var jwtSecretNameRe = regexp.MustCompile(`(?i)jwt|secret|signing|hmac`)
+
+func init() {
+	Register(funcRule{"hardcoded_jwt_secret", checkHardcodedJWTSecret})
+}
+
+// checkHardcodedJWTSecret looks for the three ways this codebase has
+// seen JWT signing keys go wrong: the key baked in as a literal, a
+// key-callback that just hands back that literal, and claims minted
+// with no (or a very long) expiry.
+func checkHardcodedJWTSecret(p *Pass) {
+	checkJWTKeyLiterals(p)
+	checkJWTKeyCallbacks(p)
+	checkJWTClaimsExpiry(p)
+}
+
+func checkJWTKeyLiterals(p *Pass) {
+	for _, decl := range p.File.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || (gd.Tok != token.VAR && gd.Tok != token.CONST) {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok {
+				continue
+			}
+			for i, name := range vs.Names {
+				if i >= len(vs.Values) || !jwtSecretNameRe.MatchString(name.Name) {
+					continue
+				}
+				if isByteOrStringLiteral(vs.Values[i]) {
+					p.Report(vs.Values[i], "hardcoded_jwt_secret",
+						fmt.Sprintf("package-level %q looks like a hardcoded JWT signing key", name.Name), SeverityCritical)
+				}
+			}
+		}
+	}
+}
+
+// isByteOrStringLiteral reports whether expr is a string literal or a
+// []byte("...") conversion of one.
+func isByteOrStringLiteral(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		return e.Kind == token.STRING
+	case *ast.CallExpr:
+		arr, ok := e.Fun.(*ast.ArrayType)
+		if !ok || len(e.Args) != 1 {
+			return false
+		}
+		elt, ok := arr.Elt.(*ast.Ident)
+		if !ok || elt.Name != "byte" {
+			return false
+		}
+		lit, ok := e.Args[0].(*ast.BasicLit)
+		return ok && lit.Kind == token.STRING
+	}
+	return false
+}
+
+// checkJWTKeyCallbacks flags jwt.ParseWithClaims/token.SignedString
+// calls whose key-returning closure just returns a package-level
+// identifier — i.e. the "dynamic" key lookup is really the same
+// static secret every caller shares.
+func checkJWTKeyCallbacks(p *Pass) {
+	pkgIdents := packageLevelIdentNames(p.File)
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		call, ok := asCall(n)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "ParseWithClaims" && sel.Sel.Name != "SignedString") {
+			return true
+		}
+		for _, arg := range call.Args {
+			fn, ok := arg.(*ast.FuncLit)
+			if !ok || fn.Body == nil {
+				continue
+			}
+			for _, stmt := range fn.Body.List {
+				ret, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(ret.Results) == 0 {
+					continue
+				}
+				ident, ok := ret.Results[0].(*ast.Ident)
+				if ok && pkgIdents[ident.Name] {
+					p.Report(call, "hardcoded_jwt_secret",
+						fmt.Sprintf("%s key callback returns package-level %q instead of a per-token key", sel.Sel.Name, ident.Name), SeverityHigh)
+				}
+			}
+		}
+		return true
+	})
+}
+
+func packageLevelIdentNames(f *ast.File) map[string]bool {
+	names := map[string]bool{}
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || (gd.Tok != token.VAR && gd.Tok != token.CONST) {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			if vs, ok := spec.(*ast.ValueSpec); ok {
+				for _, name := range vs.Names {
+					names[name.Name] = true
+				}
+			}
+		}
+	}
+	return names
+}
+
+// checkJWTClaimsExpiry flags StandardClaims/RegisteredClaims literals
+// with no ExpiresAt, or one set further than 24h out.
+func checkJWTClaimsExpiry(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		cl, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		if claimsTypeName(cl.Type) == "" {
+			return true
+		}
+
+		var expiresAt ast.Expr
+		for _, elt := range cl.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "ExpiresAt" {
+				expiresAt = kv.Value
+			}
+		}
+
+		switch {
+		case expiresAt == nil:
+			p.Report(cl, "hardcoded_jwt_secret", claimsTypeName(cl.Type)+" constructed without ExpiresAt — the token never expires", SeverityHigh)
+		case exceedsOneDay(expiresAt):
+			p.Report(expiresAt, "hardcoded_jwt_secret", "ExpiresAt is set more than 24h in the future", SeverityMedium)
+		}
+		return true
+	})
+}
+
+func claimsTypeName(t ast.Expr) string {
+	switch e := t.(type) {
+	case *ast.Ident:
+		if e.Name == "StandardClaims" || e.Name == "RegisteredClaims" {
+			return e.Name
+		}
+	case *ast.SelectorExpr:
+		if e.Sel.Name == "StandardClaims" || e.Sel.Name == "RegisteredClaims" {
+			return e.Sel.Name
+		}
+	}
+	return ""
+}
+
+// exceedsOneDay heuristically evaluates duration expressions like
+// time.Hour*72 or time.Now().Add(time.Hour*72).Unix().
+func exceedsOneDay(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		if hours, ok := hourMultiplier(e); ok {
+			return hours > 24
+		}
+		return exceedsOneDay(e.X) || exceedsOneDay(e.Y)
+	case *ast.CallExpr:
+		for _, a := range e.Args {
+			if exceedsOneDay(a) {
+				return true
+			}
+		}
+		if sel, ok := e.Fun.(*ast.SelectorExpr); ok {
+			return exceedsOneDay(sel.X)
+		}
+	}
+	return false
+}
+
+func hourMultiplier(e *ast.BinaryExpr) (float64, bool) {
+	if e.Op != token.MUL {
+		return 0, false
+	}
+	lit, timeExpr, ok := literalAndOther(e.X, e.Y)
+	if !ok {
+		return 0, false
+	}
+	sel, ok := timeExpr.(*ast.SelectorExpr)
+	if !ok {
+		return 0, false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok || pkg.Name != "time" {
+		return 0, false
+	}
+	val, err := strconv.ParseFloat(lit.Value, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch sel.Sel.Name {
+	case "Hour":
+		return val, true
+	case "Minute":
+		return val / 60, true
+	case "Second":
+		return val / 3600, true
+	}
+	return 0, false
+}
+
+func literalAndOther(a, b ast.Expr) (*ast.BasicLit, ast.Expr, bool) {
+	if lit, ok := a.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		return lit, b, true
+	}
+	if lit, ok := b.(*ast.BasicLit); ok && lit.Kind == token.INT {
+		return lit, a, true
+	}
+	return nil, nil, false
+}
@@ -0,0 +1,162 @@
+package analyzer
+
+import (
+	"go/parser"
+	"testing"
+)
+
+func TestCheckHardcodedJWTSecret_KeyLiteral(t *testing.T) {
+	const src = `package sample
+
+var jwtSigningKey = []byte("super-secret-value")
+
+func main() {}
+`
+	findings := findingsForRule(scanSource(t, src), "hardcoded_jwt_secret")
+	if len(findings) == 0 {
+		t.Fatalf("expected hardcoded_jwt_secret finding for package-level []byte literal, got none")
+	}
+}
+
+func TestCheckHardcodedJWTSecret_StringLiteralNameMatch(t *testing.T) {
+	const src = `package sample
+
+var hmacSecret = "super-secret-value"
+
+func main() {}
+`
+	findings := findingsForRule(scanSource(t, src), "hardcoded_jwt_secret")
+	if len(findings) == 0 {
+		t.Fatalf("expected hardcoded_jwt_secret finding for string literal named like a signing key, got none")
+	}
+}
+
+func TestCheckHardcodedJWTSecret_NonMatchingNameIgnored(t *testing.T) {
+	const src = `package sample
+
+var greeting = "hello, world"
+
+func main() {}
+`
+	findings := findingsForRule(scanSource(t, src), "hardcoded_jwt_secret")
+	if len(findings) != 0 {
+		t.Fatalf("expected no hardcoded_jwt_secret finding for an unrelated string literal, got %d", len(findings))
+	}
+}
+
+func TestCheckHardcodedJWTSecret_KeyCallbackReturnsPackageLevelIdent(t *testing.T) {
+	const src = `package sample
+
+var jwtSecret = []byte("super-secret-value")
+
+func parse(tokenString string) {
+	jwt.ParseWithClaims(tokenString, &Claims{}, func(t *jwt.Token) (interface{}, error) {
+		return jwtSecret, nil
+	})
+}
+`
+	findings := findingsForRule(scanSource(t, src), "hardcoded_jwt_secret")
+	if len(findings) < 2 {
+		t.Fatalf("expected findings for both the key literal and the callback returning it, got %d", len(findings))
+	}
+}
+
+func TestCheckHardcodedJWTSecret_ClaimsExpiry(t *testing.T) {
+	cases := []struct {
+		name      string
+		src       string
+		wantFlags bool
+	}{
+		{
+			name: "missing ExpiresAt",
+			src: `package sample
+
+func claims() jwt.StandardClaims {
+	return jwt.StandardClaims{Issuer: "spc"}
+}
+`,
+			wantFlags: true,
+		},
+		{
+			name: "short lived expiry not flagged",
+			src: `package sample
+
+func claims() jwt.StandardClaims {
+	return jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour * 2).Unix()}
+}
+`,
+			wantFlags: false,
+		},
+		{
+			name: "long lived expiry flagged",
+			src: `package sample
+
+func claims() jwt.StandardClaims {
+	return jwt.StandardClaims{ExpiresAt: time.Now().Add(time.Hour * 72).Unix()}
+}
+`,
+			wantFlags: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := findingsForRule(scanSource(t, tc.src), "hardcoded_jwt_secret")
+			if got := len(findings) > 0; got != tc.wantFlags {
+				t.Errorf("claims expiry findings = %d, wantFlags = %v", len(findings), tc.wantFlags)
+			}
+		})
+	}
+}
+
+func TestExceedsOneDay(t *testing.T) {
+	cases := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{"two hours", "time.Hour * 2", false},
+		{"seventy two hours", "time.Hour * 72", true},
+		{"thirty minutes", "time.Minute * 30", false},
+		{"two thousand minutes", "time.Minute * 2000", true},
+		{"wrapped in time.Now().Add", "time.Now().Add(time.Hour * 72)", true},
+		{"raw unix-epoch arithmetic is not understood", "time.Now().Unix() + 86401", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tc.expr)
+			if err != nil {
+				t.Fatalf("parsing %q: %v", tc.expr, err)
+			}
+			if got := exceedsOneDay(expr); got != tc.want {
+				t.Errorf("exceedsOneDay(%s) = %v, want %v", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsByteOrStringLiteral(t *testing.T) {
+	cases := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{"string literal", `"abc"`, true},
+		{"byte slice conversion", `[]byte("abc")`, true},
+		{"identifier is not a literal", "someVar", false},
+		{"function call is not a literal", "deriveKey()", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			expr, err := parser.ParseExpr(tc.expr)
+			if err != nil {
+				t.Fatalf("parsing %q: %v", tc.expr, err)
+			}
+			if got := isByteOrStringLiteral(expr); got != tc.want {
+				t.Errorf("isByteOrStringLiteral(%s) = %v, want %v", tc.expr, got, tc.want)
+			}
+		})
+	}
+}
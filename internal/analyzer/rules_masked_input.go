@@ -0,0 +1,122 @@
+package analyzer
+
+import (
+	"go/ast"
+	"regexp"
+)
+
+// promptKeywordRe matches prompt text or destination variable names
+// that suggest the value being read is a credential rather than
+// ordinary input.
+var promptKeywordRe = regexp.MustCompile(`(?i)password|secret|token|passphrase`)
+
+func init() {
+	Register(funcRule{"masked_secret_input", checkMaskedSecretInput})
+}
+
+// checkMaskedSecretInput extends unsafe_input: a stdin read whose
+// destination name or nearby prompt text looks like a credential
+// should go through a masked (echo-off) helper instead of a plain
+// bufio read, since the terminal would otherwise echo it back.
+func checkMaskedSecretInput(p *Pass) {
+	ast.Inspect(p.File, func(n ast.Node) bool {
+		block, ok := n.(*ast.BlockStmt)
+		if !ok {
+			return true
+		}
+		for i, stmt := range block.List {
+			call, dest, ok := stdinReadCall(stmt)
+			if !ok {
+				continue
+			}
+			if promptKeywordRe.MatchString(dest) || nearbyPromptMatches(block.List, i) {
+				p.Report(call, "masked_secret_input",
+					"credential appears to be read from stdin in plaintext; use a TTY-aware masked input helper (e.g. golang.org/x/term.ReadPassword) with restore-on-signal",
+					SeverityMedium)
+			}
+		}
+		return true
+	})
+}
+
+// stdinReadCall recognizes the "x, err := reader.ReadString(...)" /
+// "ok := scanner.Scan()" shapes and returns the call plus the
+// destination identifier's name, if any.
+func stdinReadCall(stmt ast.Stmt) (*ast.CallExpr, string, bool) {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Rhs) != 1 {
+		return nil, "", false
+	}
+	call, ok := assign.Rhs[0].(*ast.CallExpr)
+	if !ok {
+		return nil, "", false
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+	switch sel.Sel.Name {
+	case "ReadString", "ReadLine", "ReadBytes", "Scan":
+	default:
+		return nil, "", false
+	}
+
+	var dest string
+	if len(assign.Lhs) > 0 {
+		if ident, ok := assign.Lhs[0].(*ast.Ident); ok {
+			dest = ident.Name
+		}
+	}
+	return call, dest, true
+}
+
+// nearbyPromptMatches walks backward up to three statements from idx
+// looking for a Print-style call whose literal argument mentions a
+// credential keyword — the realistic "prompt-then-read" shape, rather
+// than flagging every stdin read in the file.
+func nearbyPromptMatches(stmts []ast.Stmt, idx int) bool {
+	for i := idx - 1; i >= 0 && i >= idx-3; i-- {
+		if promptCallMatches(stmts[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func promptCallMatches(stmt ast.Stmt) bool {
+	es, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return false
+	}
+	call, ok := es.X.(*ast.CallExpr)
+	if !ok || !isPromptCall(call) {
+		return false
+	}
+	for _, arg := range call.Args {
+		if lit, ok := arg.(*ast.BasicLit); ok && promptKeywordRe.MatchString(lit.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPromptCall recognizes fmt.Print/Printf/Println and
+// os.Stdout.Write as ways a program prompts before reading stdin.
+func isPromptCall(call *ast.CallExpr) bool {
+	if pkg, name, ok := selectorName(call.Fun); ok && pkg == "fmt" {
+		switch name {
+		case "Print", "Printf", "Println":
+			return true
+		}
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Write" {
+		return false
+	}
+	inner, ok := sel.X.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	pkgIdent, ok := inner.X.(*ast.Ident)
+	return ok && pkgIdent.Name == "os" && inner.Sel.Name == "Stdout"
+}
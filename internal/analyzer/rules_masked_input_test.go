@@ -0,0 +1,114 @@
+package analyzer
+
+import "testing"
+
+func TestCheckMaskedSecretInput_PromptThenRead(t *testing.T) {
+	const src = `package sample
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Enter password: ")
+	input, _ := reader.ReadString('\n')
+	fmt.Println(input)
+}
+`
+	findings := findingsForRule(scanSource(t, src), "masked_secret_input")
+	if len(findings) == 0 {
+		t.Fatalf("expected masked_secret_input finding for a password prompt followed by a plaintext read")
+	}
+}
+
+func TestCheckMaskedSecretInput_SecretNamedDestinationWithoutPrompt(t *testing.T) {
+	const src = `package sample
+
+import (
+	"bufio"
+	"os"
+)
+
+func main() {
+	reader := bufio.NewReader(os.Stdin)
+	secretToken, _ := reader.ReadString('\n')
+	_ = secretToken
+}
+`
+	findings := findingsForRule(scanSource(t, src), "masked_secret_input")
+	if len(findings) == 0 {
+		t.Fatalf("expected masked_secret_input finding when the destination variable name itself looks like a credential")
+	}
+}
+
+func TestCheckMaskedSecretInput_OrdinaryReadNotFlagged(t *testing.T) {
+	const src = `package sample
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Enter your name: ")
+	name, _ := reader.ReadString('\n')
+	fmt.Println(name)
+}
+`
+	findings := findingsForRule(scanSource(t, src), "masked_secret_input")
+	if len(findings) != 0 {
+		t.Fatalf("expected no masked_secret_input finding for an ordinary, non-credential prompt, got %d", len(findings))
+	}
+}
+
+func TestCheckMaskedSecretInput_PromptTooFarBackIgnored(t *testing.T) {
+	const src = `package sample
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Enter password: ")
+	fmt.Println("one")
+	fmt.Println("two")
+	fmt.Println("three")
+	input, _ := reader.ReadString('\n')
+	fmt.Println(input)
+}
+`
+	findings := findingsForRule(scanSource(t, src), "masked_secret_input")
+	if len(findings) != 0 {
+		t.Fatalf("expected the prompt lookback window to be bounded to a few statements, got %d findings", len(findings))
+	}
+}
+
+func TestCheckMaskedSecretInput_ScannerRecognized(t *testing.T) {
+	const src = `package sample
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+)
+
+func main() {
+	scanner := bufio.NewScanner(os.Stdin)
+	fmt.Print("Enter passphrase: ")
+	ok := scanner.Scan()
+	_ = ok
+}
+`
+	findings := findingsForRule(scanSource(t, src), "masked_secret_input")
+	if len(findings) == 0 {
+		t.Fatalf("expected masked_secret_input finding for a Scanner.Scan read following a passphrase prompt")
+	}
+}
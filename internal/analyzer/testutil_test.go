@@ -0,0 +1,33 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// scanSource writes src to a temporary .go file and runs the full
+// registered rule set over it, returning every finding.
+func scanSource(t *testing.T, src string) []Finding {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "sample.go")
+	if err := os.WriteFile(path, []byte(src), 0o644); err != nil {
+		t.Fatalf("writing sample source: %v", err)
+	}
+	findings, err := ScanPaths([]string{path})
+	if err != nil {
+		t.Fatalf("scanning sample source: %v", err)
+	}
+	return findings
+}
+
+// findingsForRule filters findings down to a single rule ID.
+func findingsForRule(findings []Finding, rule string) []Finding {
+	var out []Finding
+	for _, f := range findings {
+		if f.Rule == rule {
+			out = append(out, f)
+		}
+	}
+	return out
+}
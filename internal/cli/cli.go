@@ -0,0 +1,81 @@
+// Package cli wires the spc subcommands to the analyzer.
+package cli
+
+import (
+	"flag"
+	"os"
+
+	"github.com/putervision/spc/internal/analyzer"
+	"github.com/putervision/spc/internal/report"
+	"github.com/putervision/spc/internal/server"
+)
+
+// Run parses args and executes the requested subcommand. With no
+// recognized subcommand it falls back to scanning the given paths
+// (or the current directory) and printing findings in the -format
+// chosen (text by default, one finding per line).
+func Run(args []string) error {
+	if len(args) > 0 && args[0] == "serve" {
+		return serve(args[1:])
+	}
+	return scan(args)
+}
+
+func serve(args []string) error {
+	fs := flag.NewFlagSet("spc serve", flag.ContinueOnError)
+	addr := fs.String("addr", ":8085", "address to listen on")
+	repo := fs.String("repo", ".", "repository root to scan and serve findings for")
+	dbPath := fs.String("db", "spc.db", "path to the SQLite scan-history database")
+	user := fs.String("user", "", "HTTP basic auth username (auth disabled if both -user and -pass are empty)")
+	pass := fs.String("pass", "", "HTTP basic auth password (auth disabled if both -user and -pass are empty)")
+	webhookSecret := fs.String("webhook-secret", "", "shared secret for validating push webhooks (X-Hub-Signature-256)")
+	fs.Parse(args)
+
+	srv, err := server.New(server.Config{
+		RepoRoot:      *repo,
+		DBPath:        *dbPath,
+		BasicAuthUser: *user,
+		BasicAuthPass: *pass,
+		WebhookSecret: *webhookSecret,
+	})
+	if err != nil {
+		return err
+	}
+	defer srv.Close()
+
+	if _, err := srv.Rescan(); err != nil {
+		return err
+	}
+
+	return srv.ListenAndServe(*addr)
+}
+
+func scan(args []string) error {
+	fs := flag.NewFlagSet("spc", flag.ContinueOnError)
+	deprecations := fs.String("deprecations", "", "path to a YAML file of additional deprecated_api rules")
+	format := fs.String("format", "text", "output format: text, json, or sarif")
+	fs.Parse(args)
+
+	if *deprecations != "" {
+		if err := analyzer.LoadDeprecationRules(*deprecations); err != nil {
+			return err
+		}
+	}
+
+	rep, err := report.New(*format, os.Stdout)
+	if err != nil {
+		return err
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"."}
+	}
+
+	findings, err := analyzer.ScanPaths(paths)
+	if err != nil {
+		return err
+	}
+
+	return rep.Report(findings)
+}
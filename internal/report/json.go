@@ -0,0 +1,54 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+// JSON renders findings as a stable, versioned JSON document so CI
+// systems can diff or post-process results across runs.
+type JSON struct {
+	W io.Writer
+}
+
+// jsonDocument is schema version 1 of the JSON finding report.
+type jsonDocument struct {
+	Schema   string        `json:"schema"`
+	Findings []jsonFinding `json:"findings"`
+}
+
+type jsonFinding struct {
+	RuleID      string `json:"ruleId"`
+	Severity    string `json:"severity"`
+	Message     string `json:"message"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	Snippet     string `json:"snippet,omitempty"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+func (j *JSON) Report(findings []analyzer.Finding) error {
+	doc := jsonDocument{
+		Schema:   "spc/v1",
+		Findings: make([]jsonFinding, 0, len(findings)),
+	}
+	for _, f := range findings {
+		doc.Findings = append(doc.Findings, jsonFinding{
+			RuleID:      f.Rule,
+			Severity:    string(f.Severity),
+			Message:     f.Message,
+			File:        f.File,
+			Line:        f.Line,
+			Column:      f.Column,
+			Snippet:     snippet(f.File, f.Line),
+			Fingerprint: Fingerprint(f),
+		})
+	}
+
+	enc := json.NewEncoder(j.W)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
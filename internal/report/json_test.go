@@ -0,0 +1,39 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+func TestJSON_Report(t *testing.T) {
+	var buf bytes.Buffer
+	j := &JSON{W: &buf}
+	finding := sampleFinding("main.go", 10)
+
+	if err := j.Report([]analyzer.Finding{finding}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	var doc jsonDocument
+	if err := json.Unmarshal(buf.Bytes(), &doc); err != nil {
+		t.Fatalf("unmarshaling report: %v", err)
+	}
+
+	if doc.Schema != "spc/v1" {
+		t.Errorf("Schema = %q, want %q", doc.Schema, "spc/v1")
+	}
+	if len(doc.Findings) != 1 {
+		t.Fatalf("len(Findings) = %d, want 1", len(doc.Findings))
+	}
+
+	got := doc.Findings[0]
+	if got.RuleID != finding.Rule || got.Severity != string(finding.Severity) || got.File != finding.File || got.Line != finding.Line {
+		t.Errorf("finding = %+v, want it to mirror %+v", got, finding)
+	}
+	if got.Fingerprint != Fingerprint(finding) {
+		t.Errorf("Fingerprint = %q, want %q", got.Fingerprint, Fingerprint(finding))
+	}
+}
@@ -0,0 +1,59 @@
+// Package report renders analyzer findings in the output formats the
+// CLI supports (text, JSON, SARIF).
+package report
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+// Reporter writes a set of findings to its destination.
+type Reporter interface {
+	Report(findings []analyzer.Finding) error
+}
+
+// New returns the Reporter for the given format name ("text", "json",
+// or "sarif"), writing to w.
+func New(format string, w *os.File) (Reporter, error) {
+	switch format {
+	case "", "text":
+		return &Text{W: w}, nil
+	case "json":
+		return &JSON{W: w}, nil
+	case "sarif":
+		return &SARIF{W: w}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q (want text, json, or sarif)", format)
+	}
+}
+
+// Fingerprint returns a stable hash identifying a finding so the same
+// violation reported across runs (or by different tools ingesting the
+// JSON/SARIF output) can be deduplicated or suppressed.
+func Fingerprint(f analyzer.Finding) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%d", f.Rule, f.File, f.Line, f.Column)))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
+// snippet best-effort reads the source line a finding points at. A
+// read failure (file moved, line out of range) just yields "".
+func snippet(file string, line int) string {
+	f, err := os.Open(file)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for i := 1; scanner.Scan(); i++ {
+		if i == line {
+			return scanner.Text()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,79 @@
+package report
+
+import (
+	"os"
+	"testing"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+func sampleFinding(file string, line int) analyzer.Finding {
+	return analyzer.Finding{
+		Rule:     "weak_crypto",
+		Message:  "md5 is not a secure cryptographic primitive",
+		File:     file,
+		Line:     line,
+		Column:   2,
+		Severity: analyzer.SeverityHigh,
+	}
+}
+
+func TestNew(t *testing.T) {
+	for _, format := range []string{"", "text", "json", "sarif"} {
+		if _, err := New(format, os.Stdout); err != nil {
+			t.Errorf("New(%q) returned unexpected error: %v", format, err)
+		}
+	}
+
+	if _, err := New("xml", os.Stdout); err == nil {
+		t.Errorf("New(%q) expected an error for an unsupported format", "xml")
+	}
+}
+
+func TestFingerprint_StableForSameFinding(t *testing.T) {
+	a := sampleFinding("main.go", 10)
+	b := sampleFinding("main.go", 10)
+	if Fingerprint(a) != Fingerprint(b) {
+		t.Errorf("expected identical findings to produce the same fingerprint")
+	}
+}
+
+func TestFingerprint_DiffersByLocation(t *testing.T) {
+	a := sampleFinding("main.go", 10)
+	b := sampleFinding("main.go", 11)
+	if Fingerprint(a) == Fingerprint(b) {
+		t.Errorf("expected findings on different lines to produce different fingerprints")
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	path := tempFileWithLines(t, "line one", "line two", "line three")
+
+	if got := snippet(path, 2); got != "line two" {
+		t.Errorf("snippet(path, 2) = %q, want %q", got, "line two")
+	}
+	if got := snippet(path, 99); got != "" {
+		t.Errorf("snippet out of range = %q, want empty string", got)
+	}
+	if got := snippet("/does/not/exist.go", 1); got != "" {
+		t.Errorf("snippet for missing file = %q, want empty string", got)
+	}
+}
+
+// tempFileWithLines writes lines (joined with \n) to a temp file and
+// returns its path.
+func tempFileWithLines(t *testing.T, lines ...string) string {
+	t.Helper()
+	path := t.TempDir() + "/sample.go"
+	content := ""
+	for i, l := range lines {
+		if i > 0 {
+			content += "\n"
+		}
+		content += l
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing temp file: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,139 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+// SARIF renders findings as SARIF 2.1.0 so they can be uploaded to
+// GitHub code scanning, GitLab, or any other SARIF-consuming tool.
+type SARIF struct {
+	W io.Writer
+}
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type sarifResult struct {
+	RuleID              string            `json:"ruleId"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int           `json:"startLine"`
+	StartColumn int           `json:"startColumn"`
+	Snippet     *sarifMessage `json:"snippet,omitempty"`
+}
+
+func (s *SARIF) Report(findings []analyzer.Finding) error {
+	log := sarifLog{
+		Schema:  sarifSchema,
+		Version: "2.1.0",
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "spc",
+				InformationURI: "https://github.com/putervision/spc",
+				Rules:          sarifRules(findings),
+			}},
+			Results: make([]sarifResult, 0, len(findings)),
+		}},
+	}
+
+	for _, f := range findings {
+		var snip *sarifMessage
+		if text := snippet(f.File, f.Line); text != "" {
+			snip = &sarifMessage{Text: text}
+		}
+		log.Runs[0].Results = append(log.Runs[0].Results, sarifResult{
+			RuleID:  f.Rule,
+			Level:   sarifLevel(f.Severity),
+			Message: sarifMessage{Text: f.Message},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: f.File},
+				Region: sarifRegion{
+					StartLine:   f.Line,
+					StartColumn: f.Column,
+					Snippet:     snip,
+				},
+			}}},
+			PartialFingerprints: map[string]string{"spcFingerprint/v1": Fingerprint(f)},
+		})
+	}
+
+	enc := json.NewEncoder(s.W)
+	enc.SetIndent("", "  ")
+	return enc.Encode(log)
+}
+
+func sarifRules(findings []analyzer.Finding) []sarifRule {
+	seen := map[string]bool{}
+	var rules []sarifRule
+	for _, f := range findings {
+		if seen[f.Rule] {
+			continue
+		}
+		seen[f.Rule] = true
+		rules = append(rules, sarifRule{ID: f.Rule, Name: f.Rule})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].ID < rules[j].ID })
+	return rules
+}
+
+func sarifLevel(sev analyzer.Severity) string {
+	switch sev {
+	case analyzer.SeverityCritical, analyzer.SeverityHigh:
+		return "error"
+	case analyzer.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
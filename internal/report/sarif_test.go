@@ -0,0 +1,85 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+func TestSARIF_Report(t *testing.T) {
+	var buf bytes.Buffer
+	s := &SARIF{W: &buf}
+	finding := sampleFinding("main.go", 10)
+
+	if err := s.Report([]analyzer.Finding{finding}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("unmarshaling SARIF log: %v", err)
+	}
+
+	if log.Version != "2.1.0" {
+		t.Errorf("Version = %q, want %q", log.Version, "2.1.0")
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("len(Runs) = %d, want 1", len(log.Runs))
+	}
+
+	run := log.Runs[0]
+	if len(run.Results) != 1 {
+		t.Fatalf("len(Results) = %d, want 1", len(run.Results))
+	}
+
+	result := run.Results[0]
+	if result.RuleID != finding.Rule {
+		t.Errorf("RuleID = %q, want %q", result.RuleID, finding.Rule)
+	}
+	if result.Level != "error" {
+		t.Errorf("Level = %q, want %q for severity %q", result.Level, "error", finding.Severity)
+	}
+	if got := result.PartialFingerprints["spcFingerprint/v1"]; got != Fingerprint(finding) {
+		t.Errorf("fingerprint = %q, want %q", got, Fingerprint(finding))
+	}
+
+	loc := result.Locations[0].PhysicalLocation
+	if loc.ArtifactLocation.URI != finding.File || loc.Region.StartLine != finding.Line {
+		t.Errorf("location = %+v, want file %q line %d", loc, finding.File, finding.Line)
+	}
+}
+
+func TestSarifLevel(t *testing.T) {
+	cases := []struct {
+		sev  analyzer.Severity
+		want string
+	}{
+		{analyzer.SeverityCritical, "error"},
+		{analyzer.SeverityHigh, "error"},
+		{analyzer.SeverityMedium, "warning"},
+		{analyzer.SeverityLow, "note"},
+		{analyzer.SeverityInfo, "note"},
+	}
+	for _, tc := range cases {
+		if got := sarifLevel(tc.sev); got != tc.want {
+			t.Errorf("sarifLevel(%s) = %q, want %q", tc.sev, got, tc.want)
+		}
+	}
+}
+
+func TestSarifRules_DeduplicatesAndSorts(t *testing.T) {
+	findings := []analyzer.Finding{
+		sampleFinding("a.go", 1),
+		{Rule: "exposed_secrets", Severity: analyzer.SeverityCritical, File: "a.go", Line: 2},
+		sampleFinding("b.go", 3),
+	}
+	rules := sarifRules(findings)
+	if len(rules) != 2 {
+		t.Fatalf("len(rules) = %d, want 2", len(rules))
+	}
+	if rules[0].ID != "exposed_secrets" || rules[1].ID != "weak_crypto" {
+		t.Errorf("rules = %+v, want sorted [exposed_secrets, weak_crypto]", rules)
+	}
+}
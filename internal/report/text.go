@@ -0,0 +1,23 @@
+package report
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+// Text renders findings the way spc always has: one
+// "file:line:col: [rule] message" line per finding.
+type Text struct {
+	W io.Writer
+}
+
+func (t *Text) Report(findings []analyzer.Finding) error {
+	for _, f := range findings {
+		if _, err := fmt.Fprintf(t.W, "%s:%d:%d: [%s] %s\n", f.File, f.Line, f.Column, f.Rule, f.Message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+func TestText_Report(t *testing.T) {
+	var buf bytes.Buffer
+	text := &Text{W: &buf}
+
+	if err := text.Report([]analyzer.Finding{sampleFinding("main.go", 10)}); err != nil {
+		t.Fatalf("Report: %v", err)
+	}
+
+	want := "main.go:10:2: [weak_crypto] md5 is not a secure cryptographic primitive\n"
+	if buf.String() != want {
+		t.Errorf("Report output = %q, want %q", buf.String(), want)
+	}
+}
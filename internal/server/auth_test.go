@@ -0,0 +1,102 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequireAuth_DisabledWhenUnconfigured(t *testing.T) {
+	s := &Server{cfg: Config{}}
+	called := false
+	h := s.requireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if !called {
+		t.Fatal("expected the wrapped handler to run when no credentials are configured")
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestRequireAuth_RejectsMissingOrWrongCredentials(t *testing.T) {
+	s := &Server{cfg: Config{BasicAuthUser: "admin", BasicAuthPass: "hunter2"}}
+	called := false
+	h := s.requireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	tests := []struct {
+		name       string
+		setBasic   bool
+		user, pass string
+	}{
+		{name: "no credentials", setBasic: false},
+		{name: "wrong user", setBasic: true, user: "eve", pass: "hunter2"},
+		{name: "wrong password", setBasic: true, user: "admin", pass: "wrong"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			called = false
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.setBasic {
+				req.SetBasicAuth(tc.user, tc.pass)
+			}
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			if called {
+				t.Error("wrapped handler ran despite invalid credentials")
+			}
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+			if rec.Header().Get("WWW-Authenticate") == "" {
+				t.Error("expected a WWW-Authenticate header on 401")
+			}
+		})
+	}
+}
+
+func TestRequireAuth_AcceptsCorrectCredentials(t *testing.T) {
+	s := &Server{cfg: Config{BasicAuthUser: "admin", BasicAuthPass: "hunter2"}}
+	called := false
+	h := s.requireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.SetBasicAuth("admin", "hunter2")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if !called {
+		t.Fatal("expected the wrapped handler to run for correct credentials")
+	}
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestConstantTimeEqual(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want bool
+	}{
+		{"hunter2", "hunter2", true},
+		{"hunter2", "hunter3", false},
+		{"hunter2", "hunter22", false},
+		{"", "", true},
+	}
+	for _, tc := range cases {
+		if got := constantTimeEqual(tc.a, tc.b); got != tc.want {
+			t.Errorf("constantTimeEqual(%q, %q) = %v, want %v", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package server
+
+import (
+	"bytes"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// BlameAuthor returns the git-blame author of file:line relative to
+// repoRoot. It shells out to `git blame` rather than linking a Git
+// implementation in-process, matching how the analyzer itself treats
+// the filesystem as the source of truth.
+func BlameAuthor(repoRoot, file string, line int) (string, error) {
+	rel, err := filepath.Rel(repoRoot, file)
+	if err != nil {
+		rel = file
+	}
+
+	cmd := exec.Command("git", "blame", "--porcelain", "-L", lineRange(line), "--", rel)
+	cmd.Dir = repoRoot
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	if err := cmd.Run(); err != nil {
+		return "", err
+	}
+
+	for _, l := range strings.Split(out.String(), "\n") {
+		if author, ok := strings.CutPrefix(l, "author "); ok {
+			return author, nil
+		}
+	}
+	return "", nil
+}
+
+func lineRange(line int) string {
+	s := strconv.Itoa(line)
+	return s + "," + s
+}
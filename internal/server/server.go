@@ -0,0 +1,81 @@
+// Package server implements `spc serve`: a small embedded HTTP
+// dashboard for browsing scan history, backed by SQLite.
+package server
+
+import (
+	"embed"
+	"fmt"
+	"net/http"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+//go:embed web/*
+var webFS embed.FS
+
+// Config configures a dashboard Server.
+type Config struct {
+	// RepoRoot is scanned on startup and on every webhook delivery.
+	RepoRoot string
+	// DBPath is the SQLite file scan history is persisted to.
+	DBPath string
+	// BasicAuthUser/Pass gate the dashboard UI. Both empty disables auth.
+	BasicAuthUser string
+	BasicAuthPass string
+	// WebhookSecret validates the X-Hub-Signature-256 header on
+	// /webhook deliveries. Empty disables signature checking (not
+	// recommended outside local development).
+	WebhookSecret string
+}
+
+// Server serves the findings dashboard, the per-file highlighted
+// source view, and the push webhook.
+type Server struct {
+	cfg   Config
+	store *Store
+	mux   *http.ServeMux
+}
+
+// New opens (or creates) the scan-history database and wires up the
+// HTTP routes. Callers must call Close when done.
+func New(cfg Config) (*Server, error) {
+	store, err := OpenStore(cfg.DBPath)
+	if err != nil {
+		return nil, fmt.Errorf("opening scan history db: %w", err)
+	}
+
+	s := &Server{cfg: cfg, store: store, mux: http.NewServeMux()}
+	s.routes()
+	return s, nil
+}
+
+// Close releases the underlying database handle.
+func (s *Server) Close() error {
+	return s.store.Close()
+}
+
+// ListenAndServe starts the HTTP server on addr.
+func (s *Server) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, s.mux)
+}
+
+// Rescan walks the configured repo root, records the findings as a
+// new scan, and returns how many were found.
+func (s *Server) Rescan() (int, error) {
+	findings, err := analyzer.ScanPaths([]string{s.cfg.RepoRoot})
+	if err != nil {
+		return 0, err
+	}
+	if err := s.store.SaveScan(findings, s.cfg.RepoRoot); err != nil {
+		return 0, err
+	}
+	return len(findings), nil
+}
+
+func (s *Server) routes() {
+	s.mux.Handle("/", s.requireAuth(http.HandlerFunc(s.handleDashboard)))
+	s.mux.Handle("/file", s.requireAuth(http.HandlerFunc(s.handleFile)))
+	s.mux.Handle("/trend", s.requireAuth(http.HandlerFunc(s.handleTrend)))
+	// The webhook authenticates via HMAC signature, not basic auth.
+	s.mux.Handle("/webhook", http.HandlerFunc(s.handleWebhook))
+}
@@ -0,0 +1,186 @@
+package server
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+// Store persists scan history to SQLite so the dashboard can show
+// trends (e.g. "weak_crypto findings over time") rather than just the
+// latest snapshot.
+type Store struct {
+	db *sql.DB
+}
+
+// StoredFinding is a Finding plus the scan it came from and the
+// git-blame author of the flagged line.
+type StoredFinding struct {
+	analyzer.Finding
+	ScanID    int64
+	ScannedAt string
+	Author    string
+}
+
+// Filter narrows ListFindings results. Empty fields match anything.
+type Filter struct {
+	Rule     string
+	Severity string
+	File     string
+	Author   string
+}
+
+// TrendPoint is one data point in a rule's finding-count-over-time series.
+type TrendPoint struct {
+	ScannedAt string
+	Count     int
+}
+
+// OpenStore opens (creating if necessary) the SQLite database at path
+// and ensures its schema exists.
+func OpenStore(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrating schema: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+const schema = `
+CREATE TABLE IF NOT EXISTS scans (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	repo_root TEXT NOT NULL,
+	scanned_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE IF NOT EXISTS findings (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	scan_id INTEGER NOT NULL REFERENCES scans(id),
+	rule TEXT NOT NULL,
+	severity TEXT NOT NULL,
+	message TEXT NOT NULL,
+	file TEXT NOT NULL,
+	line INTEGER NOT NULL,
+	column INTEGER NOT NULL,
+	author TEXT NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_findings_rule ON findings(rule);
+CREATE INDEX IF NOT EXISTS idx_findings_scan ON findings(scan_id);
+`
+
+// Close releases the database handle.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// SaveScan records findings as a new scan row, resolving each
+// finding's git-blame author relative to repoRoot on a best-effort
+// basis (blame failures just leave Author blank).
+func (s *Store) SaveScan(findings []analyzer.Finding, repoRoot string) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(`INSERT INTO scans (repo_root) VALUES (?)`, repoRoot)
+	if err != nil {
+		return err
+	}
+	scanID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	stmt, err := tx.Prepare(`INSERT INTO findings (scan_id, rule, severity, message, file, line, column, author) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	for _, f := range findings {
+		author, _ := BlameAuthor(repoRoot, f.File, f.Line)
+		if _, err := stmt.Exec(scanID, f.Rule, string(f.Severity), f.Message, f.File, f.Line, f.Column, author); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListFindings returns the findings from the most recent scan,
+// narrowed by any non-empty Filter fields.
+func (s *Store) ListFindings(filter Filter) ([]StoredFinding, error) {
+	query := `
+SELECT f.rule, f.severity, f.message, f.file, f.line, f.column, f.author, s.id, s.scanned_at
+FROM findings f
+JOIN scans s ON s.id = f.scan_id
+WHERE s.id = (SELECT MAX(id) FROM scans)
+`
+	var args []any
+	if filter.Rule != "" {
+		query += " AND f.rule = ?"
+		args = append(args, filter.Rule)
+	}
+	if filter.Severity != "" {
+		query += " AND f.severity = ?"
+		args = append(args, filter.Severity)
+	}
+	if filter.File != "" {
+		query += " AND f.file = ?"
+		args = append(args, filter.File)
+	}
+	if filter.Author != "" {
+		query += " AND f.author = ?"
+		args = append(args, filter.Author)
+	}
+	query += " ORDER BY f.file, f.line"
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []StoredFinding
+	for rows.Next() {
+		var sf StoredFinding
+		if err := rows.Scan(&sf.Rule, &sf.Severity, &sf.Message, &sf.File, &sf.Line, &sf.Column, &sf.Author, &sf.ScanID, &sf.ScannedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, sf)
+	}
+	return out, rows.Err()
+}
+
+// Trend returns the count of findings for rule in each scan, oldest
+// first. Scans with no findings for rule have no point in the result.
+func (s *Store) Trend(rule string) ([]TrendPoint, error) {
+	rows, err := s.db.Query(`
+SELECT s.scanned_at, COUNT(*)
+FROM findings f
+JOIN scans s ON s.id = f.scan_id
+WHERE f.rule = ?
+GROUP BY s.id
+ORDER BY s.id ASC`, rule)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TrendPoint
+	for rows.Next() {
+		var tp TrendPoint
+		if err := rows.Scan(&tp.ScannedAt, &tp.Count); err != nil {
+			return nil, err
+		}
+		out = append(out, tp)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/putervision/spc/internal/analyzer"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := OpenStore(filepath.Join(t.TempDir(), "spc.db"))
+	if err != nil {
+		t.Fatalf("OpenStore: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestStore_SaveScanAndListFindings(t *testing.T) {
+	store := newTestStore(t)
+	repoRoot := t.TempDir()
+
+	findings := []analyzer.Finding{
+		{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "md5", File: "a.go", Line: 1, Column: 1},
+		{Rule: "exposed_secrets", Severity: analyzer.SeverityCritical, Message: "hardcoded key", File: "b.go", Line: 2, Column: 1},
+	}
+	if err := store.SaveScan(findings, repoRoot); err != nil {
+		t.Fatalf("SaveScan: %v", err)
+	}
+
+	all, err := store.ListFindings(Filter{})
+	if err != nil {
+		t.Fatalf("ListFindings: %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("len(all) = %d, want 2", len(all))
+	}
+}
+
+func TestStore_ListFindings_FilterCombinations(t *testing.T) {
+	store := newTestStore(t)
+	repoRoot := t.TempDir()
+
+	findings := []analyzer.Finding{
+		{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "md5", File: "a.go", Line: 1, Column: 1},
+		{Rule: "weak_crypto", Severity: analyzer.SeverityLow, Message: "sha1", File: "b.go", Line: 2, Column: 1},
+		{Rule: "exposed_secrets", Severity: analyzer.SeverityCritical, Message: "hardcoded key", File: "a.go", Line: 3, Column: 1},
+	}
+	if err := store.SaveScan(findings, repoRoot); err != nil {
+		t.Fatalf("SaveScan: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		filter Filter
+		want   int
+	}{
+		{name: "no filter matches all", filter: Filter{}, want: 3},
+		{name: "by rule", filter: Filter{Rule: "weak_crypto"}, want: 2},
+		{name: "by severity", filter: Filter{Severity: string(analyzer.SeverityCritical)}, want: 1},
+		{name: "by file", filter: Filter{File: "a.go"}, want: 2},
+		{name: "rule and file combined", filter: Filter{Rule: "weak_crypto", File: "a.go"}, want: 1},
+		{name: "no match", filter: Filter{Rule: "does_not_exist"}, want: 0},
+		{name: "by author, none recorded", filter: Filter{Author: "nobody"}, want: 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := store.ListFindings(tc.filter)
+			if err != nil {
+				t.Fatalf("ListFindings: %v", err)
+			}
+			if len(got) != tc.want {
+				t.Errorf("len(ListFindings(%+v)) = %d, want %d", tc.filter, len(got), tc.want)
+			}
+		})
+	}
+}
+
+func TestStore_ListFindings_OnlyMostRecentScan(t *testing.T) {
+	store := newTestStore(t)
+	repoRoot := t.TempDir()
+
+	first := []analyzer.Finding{{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "md5", File: "a.go", Line: 1, Column: 1}}
+	second := []analyzer.Finding{{Rule: "exposed_secrets", Severity: analyzer.SeverityCritical, Message: "key", File: "b.go", Line: 1, Column: 1}}
+
+	if err := store.SaveScan(first, repoRoot); err != nil {
+		t.Fatalf("SaveScan (first): %v", err)
+	}
+	if err := store.SaveScan(second, repoRoot); err != nil {
+		t.Fatalf("SaveScan (second): %v", err)
+	}
+
+	got, err := store.ListFindings(Filter{})
+	if err != nil {
+		t.Fatalf("ListFindings: %v", err)
+	}
+	if len(got) != 1 || got[0].Rule != "exposed_secrets" {
+		t.Errorf("ListFindings = %+v, want only the most recent scan's exposed_secrets finding", got)
+	}
+}
+
+func TestStore_Trend_OrdersOldestFirst(t *testing.T) {
+	store := newTestStore(t)
+	repoRoot := t.TempDir()
+
+	scans := [][]analyzer.Finding{
+		{{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "m", File: "a.go", Line: 1, Column: 1}},
+		{
+			{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "m", File: "a.go", Line: 1, Column: 1},
+			{Rule: "weak_crypto", Severity: analyzer.SeverityHigh, Message: "m", File: "b.go", Line: 1, Column: 1},
+		},
+		{},
+	}
+	for _, findings := range scans {
+		if err := store.SaveScan(findings, repoRoot); err != nil {
+			t.Fatalf("SaveScan: %v", err)
+		}
+	}
+
+	points, err := store.Trend("weak_crypto")
+	if err != nil {
+		t.Fatalf("Trend: %v", err)
+	}
+	if len(points) != 2 {
+		t.Fatalf("len(points) = %d, want 2 (scans with zero weak_crypto findings don't contribute a row)", len(points))
+	}
+	if points[0].Count != 1 || points[1].Count != 2 {
+		t.Errorf("points = %+v, want counts [1, 2] oldest-first", points)
+	}
+}
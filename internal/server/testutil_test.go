@@ -0,0 +1,23 @@
+package server
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+// newTestServer wires a Server around a fresh temp-dir repo root and a
+// fresh SQLite db, with auth and webhook signature checking disabled
+// unless the test overrides s.cfg afterwards.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	repoRoot := t.TempDir()
+	dbPath := filepath.Join(t.TempDir(), "spc.db")
+
+	s, err := New(Config{RepoRoot: repoRoot, DBPath: dbPath})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
@@ -0,0 +1,135 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var templates = template.Must(template.ParseFS(webFS, "web/*.html"))
+
+func (s *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	filter := Filter{
+		Rule:     q.Get("rule"),
+		Severity: q.Get("severity"),
+		File:     q.Get("file"),
+		Author:   q.Get("author"),
+	}
+
+	findings, err := s.store.ListFindings(filter)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := struct {
+		Findings []StoredFinding
+		Filter   Filter
+	}{Findings: findings, Filter: filter}
+
+	if err := templates.ExecuteTemplate(w, "index.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleFile(w http.ResponseWriter, r *http.Request) {
+	path := r.URL.Query().Get("path")
+	if path == "" {
+		http.Error(w, "missing path", http.StatusBadRequest)
+		return
+	}
+
+	resolved, err := resolveRepoPath(s.cfg.RepoRoot, path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	findings, err := s.store.ListFindings(Filter{File: path})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	byLine := map[int][]StoredFinding{}
+	for _, f := range findings {
+		byLine[f.Line] = append(byLine[f.Line], f)
+	}
+
+	lines, err := readLines(resolved)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	type line struct {
+		Number   int
+		Text     string
+		Findings []StoredFinding
+	}
+	data := struct {
+		Path  string
+		Lines []line
+	}{Path: path}
+
+	for i, text := range lines {
+		data.Lines = append(data.Lines, line{Number: i + 1, Text: text, Findings: byLine[i+1]})
+	}
+
+	if err := templates.ExecuteTemplate(w, "file.html", data); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) handleTrend(w http.ResponseWriter, r *http.Request) {
+	rule := r.URL.Query().Get("rule")
+	if rule == "" {
+		http.Error(w, "missing rule", http.StatusBadRequest)
+		return
+	}
+	points, err := s.store.Trend(rule)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeJSON(w, points)
+}
+
+// resolveRepoPath resolves a user-supplied, potentially hostile path
+// against repoRoot and rejects anything that would escape it (e.g.
+// "../../../../etc/passwd"), so /file can't be used to read arbitrary
+// files the server process can see. An absolute-looking path such as
+// "/etc/passwd" is not rejected: it is joined under repoRoot.
+func resolveRepoPath(repoRoot, requested string) (string, error) {
+	absRoot, err := filepath.Abs(repoRoot)
+	if err != nil {
+		return "", err
+	}
+
+	joined := filepath.Clean(filepath.Join(absRoot, requested))
+	rel, err := filepath.Rel(absRoot, joined)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %q is outside the repository root", requested)
+	}
+	return joined, nil
+}
+
+func readLines(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
@@ -0,0 +1,98 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveRepoPath_RejectsEscapes(t *testing.T) {
+	root := t.TempDir()
+
+	escapes := []string{
+		"../../etc/passwd",
+		"../../../../etc/passwd",
+	}
+	for _, requested := range escapes {
+		t.Run(requested, func(t *testing.T) {
+			if _, err := resolveRepoPath(root, requested); err == nil {
+				t.Errorf("resolveRepoPath(%q) = nil error, want it to reject an escape from %q", requested, root)
+			}
+		})
+	}
+}
+
+func TestResolveRepoPath_ContainsLeadingSlashInsteadOfEscaping(t *testing.T) {
+	// filepath.Join treats "/etc/passwd" as just another path element
+	// here, not an absolute override, so it lands inside root rather
+	// than escaping it.
+	root := t.TempDir()
+
+	got, err := resolveRepoPath(root, "/etc/passwd")
+	if err != nil {
+		t.Fatalf("resolveRepoPath: %v", err)
+	}
+	want := filepath.Join(root, "etc", "passwd")
+	if got != want {
+		t.Errorf("resolveRepoPath(%q) = %q, want %q (contained under root)", "/etc/passwd", got, want)
+	}
+}
+
+func TestResolveRepoPath_AllowsPathsInsideRoot(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "main.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("writing fixture file: %v", err)
+	}
+
+	got, err := resolveRepoPath(root, "main.go")
+	if err != nil {
+		t.Fatalf("resolveRepoPath: %v", err)
+	}
+	want := filepath.Join(root, "main.go")
+	if got != want {
+		t.Errorf("resolveRepoPath = %q, want %q", got, want)
+	}
+}
+
+func TestHandleFile_RejectsPathEscape(t *testing.T) {
+	s := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/file?path=../../etc/passwd", nil)
+	rec := httptest.NewRecorder()
+	s.handleFile(rec, req)
+
+	if rec.Code != http.StatusForbidden {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusForbidden)
+	}
+}
+
+func TestHandleFile_AbsoluteLookingPathIsContainedNotForbidden(t *testing.T) {
+	s := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/file?path=/etc/passwd", nil)
+	rec := httptest.NewRecorder()
+	s.handleFile(rec, req)
+
+	// "/etc/passwd" resolves inside repoRoot (see resolveRepoPath), so
+	// this is a plain "file doesn't exist" 404, not a 403 rejection.
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestHandleFile_ServesFileInRoot(t *testing.T) {
+	s := newTestServer(t)
+	if err := os.WriteFile(filepath.Join(s.cfg.RepoRoot, "main.go"), []byte("package main\n"), 0o644); err != nil {
+		t.Fatalf("writing fixture file: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/file?path=main.go", nil)
+	rec := httptest.NewRecorder()
+	s.handleFile(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
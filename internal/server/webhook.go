@@ -0,0 +1,63 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// handleWebhook re-runs the scan when GitHub/Gitea deliver a push
+// event. The payload shape isn't inspected beyond the signature check
+// — any authenticated delivery triggers a rescan of the configured repo.
+func (s *Server) handleWebhook(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+
+	if s.cfg.WebhookSecret != "" {
+		sig := r.Header.Get("X-Hub-Signature-256")
+		if !validSignature(s.cfg.WebhookSecret, body, sig) {
+			http.Error(w, "invalid signature", http.StatusUnauthorized)
+			return
+		}
+	}
+
+	count, err := s.Rescan()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{"findings": count})
+}
+
+// validSignature checks a GitHub/Gitea-style "sha256=<hex>"
+// X-Hub-Signature-256 header using a constant-time comparison.
+func validSignature(secret string, body []byte, header string) bool {
+	const prefix = "sha256="
+	if !strings.HasPrefix(header, prefix) {
+		return false
+	}
+	want, err := hex.DecodeString(strings.TrimPrefix(header, prefix))
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	got := mac.Sum(nil)
+
+	return hmac.Equal(want, got)
+}
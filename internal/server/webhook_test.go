@@ -0,0 +1,102 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestValidSignature(t *testing.T) {
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	const secret = "topsecret"
+
+	if !validSignature(secret, body, sign(secret, body)) {
+		t.Error("expected a correctly signed payload to be valid")
+	}
+	if validSignature(secret, body, sign("wrongsecret", body)) {
+		t.Error("expected a payload signed with the wrong secret to be invalid")
+	}
+	if validSignature(secret, body, "not-even-hex") {
+		t.Error("expected a malformed header to be invalid")
+	}
+	if validSignature(secret, body, "") {
+		t.Error("expected a missing header to be invalid")
+	}
+}
+
+func TestHandleWebhook_RejectsInvalidSignature(t *testing.T) {
+	s := newTestServer(t)
+	s.cfg.WebhookSecret = "topsecret"
+
+	body := []byte(`{}`)
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(string(body)))
+	req.Header.Set("X-Hub-Signature-256", sign("wrongsecret", body))
+	rec := httptest.NewRecorder()
+	s.handleWebhook(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleWebhook_RejectsMissingSignature(t *testing.T) {
+	s := newTestServer(t)
+	s.cfg.WebhookSecret = "topsecret"
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+	s.handleWebhook(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestHandleWebhook_AcceptsValidSignatureAndRescans(t *testing.T) {
+	s := newTestServer(t)
+	s.cfg.WebhookSecret = "topsecret"
+
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(string(body)))
+	req.Header.Set("X-Hub-Signature-256", sign("topsecret", body))
+	rec := httptest.NewRecorder()
+	s.handleWebhook(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestHandleWebhook_NoSecretConfiguredSkipsSignatureCheck(t *testing.T) {
+	s := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(`{}`))
+	rec := httptest.NewRecorder()
+	s.handleWebhook(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("status = %d, want %d, body: %s", rec.Code, http.StatusOK, rec.Body.String())
+	}
+}
+
+func TestHandleWebhook_RejectsNonPost(t *testing.T) {
+	s := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/webhook", nil)
+	rec := httptest.NewRecorder()
+	s.handleWebhook(rec, req)
+
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,18 @@
+// Command spc (secure pattern check) scans Go source trees for risky
+// patterns such as hardcoded secrets, weak crypto, and unsafe input
+// handling.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/putervision/spc/internal/cli"
+)
+
+func main() {
+	if err := cli.Run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "spc:", err)
+		os.Exit(1)
+	}
+}